@@ -0,0 +1,251 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jax
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	jaxjobapi "github.com/kubeflow/training-operator/pkg/apis/jaxjob/v1"
+	commonv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow/common/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/util/maps"
+)
+
+var (
+	gvk           = jaxjobapi.GroupVersion.WithKind("JAXJob")
+	FrameworkName = "kubeflow.org/jaxjob"
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:           SetupIndexes,
+		NewReconciler:          NewReconciler,
+		SetupWebhook:           SetupJAXJobWebhook,
+		JobType:                &jaxjobapi.JAXJob{},
+		AddToScheme:            jaxjobapi.AddToScheme,
+		IsManagingObjectsOwner: isJAXJob,
+	}))
+}
+
+//+kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=list;get;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
+//+kubebuilder:rbac:groups=kubeflow.org,resources=jaxjobs,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=kubeflow.org,resources=jaxjobs/status,verbs=get;update
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+
+var NewReconciler = jobframework.NewGenericReconciler(func() jobframework.GenericJob { return &JAXJob{} }, nil)
+
+func isJAXJob(owner *metav1.OwnerReference) bool {
+	return owner.Kind == "JAXJob" && strings.HasPrefix(owner.APIVersion, "kubeflow.org/v1")
+}
+
+type JAXJob jaxjobapi.JAXJob
+
+var _ jobframework.GenericJob = (*JAXJob)(nil)
+
+func fromObject(obj runtime.Object) *JAXJob {
+	return (*JAXJob)(obj.(*jaxjobapi.JAXJob))
+}
+
+func (j *JAXJob) Object() client.Object {
+	return (*jaxjobapi.JAXJob)(j)
+}
+
+func (j *JAXJob) IsSuspended() bool {
+	return pointer.BoolDeref(j.Spec.RunPolicy.Suspend, false)
+}
+
+func (j *JAXJob) IsActive() bool {
+	for _, replicaStatus := range j.Status.ReplicaStatuses {
+		if replicaStatus.Active > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (j *JAXJob) Suspend() {
+	j.Spec.RunPolicy.Suspend = pointer.Bool(true)
+}
+
+func (j *JAXJob) ResetStatus() bool {
+	return false
+}
+
+func (j *JAXJob) GetGVK() schema.GroupVersionKind {
+	return gvk
+}
+
+// orderedReplicaTypes returns the replica types of the JAXJob in a
+// deterministic order so that PodSets, RunWithPodSetsInfo and
+// RestorePodSetsInfo all agree on the same PodSet index for a given
+// replica type.
+func orderedReplicaTypes(spec *jaxjobapi.JAXJobSpec) []commonv1.ReplicaType {
+	types := make([]commonv1.ReplicaType, 0, len(spec.JAXReplicaSpecs))
+	for rType := range spec.JAXReplicaSpecs {
+		types = append(types, rType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+func (j *JAXJob) PodSets() []kueue.PodSet {
+	replicaTypes := orderedReplicaTypes(&j.Spec)
+	podSets := make([]kueue.PodSet, len(replicaTypes))
+	for index, rType := range replicaTypes {
+		replicaSpec := j.Spec.JAXReplicaSpecs[rType]
+		podSets[index] = kueue.PodSet{
+			Name:     strings.ToLower(string(rType)),
+			Template: *replicaSpec.Template.DeepCopy(),
+			Count:    podsCount(replicaSpec),
+		}
+	}
+	return podSets
+}
+
+func (j *JAXJob) RunWithPodSetsInfo(podSetInfos []jobframework.PodSetInfo) {
+	j.Spec.RunPolicy.Suspend = pointer.Bool(false)
+	if len(podSetInfos) == 0 {
+		return
+	}
+
+	// for initially unsuspend, this should be enough however if the pods are already created
+	// the node selectors should be updated on each of them
+	for index, rType := range orderedReplicaTypes(&j.Spec) {
+		templateSpec := &j.Spec.JAXReplicaSpecs[rType].Template.Spec
+		templateSpec.NodeSelector = maps.MergeKeepFirst(podSetInfos[index].NodeSelector, templateSpec.NodeSelector)
+	}
+}
+
+func (j *JAXJob) RestorePodSetsInfo(podSetInfos []jobframework.PodSetInfo) {
+	if len(podSetInfos) == 0 {
+		return
+	}
+	for index, rType := range orderedReplicaTypes(&j.Spec) {
+		templateSpec := &j.Spec.JAXReplicaSpecs[rType].Template.Spec
+		if equality.Semantic.DeepEqual(templateSpec.NodeSelector, podSetInfos[index].NodeSelector) {
+			continue
+		}
+		templateSpec.NodeSelector = maps.Clone(podSetInfos[index].NodeSelector)
+	}
+}
+
+func (j *JAXJob) Finished() (metav1.Condition, bool) {
+	if hasJAXJobCondition(j.Status.Conditions, commonv1.JobSucceeded) {
+		condition := metav1.Condition{
+			Type:    kueue.WorkloadFinished,
+			Status:  metav1.ConditionTrue,
+			Reason:  "JAXJobFinished",
+			Message: "JAXJob finished successfully",
+		}
+		return condition, true
+	}
+	if hasJAXJobCondition(j.Status.Conditions, commonv1.JobFailed) {
+		condition := metav1.Condition{
+			Type:    kueue.WorkloadFinished,
+			Status:  metav1.ConditionTrue,
+			Reason:  "JAXJobFinished",
+			Message: "JAXJob failed",
+		}
+		return condition, true
+	}
+	return metav1.Condition{}, false
+}
+
+func hasJAXJobCondition(conditions []commonv1.JobCondition, conditionType commonv1.JobConditionType) bool {
+	for _, c := range conditions {
+		if c.Type == conditionType && c.Status == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+func (j *JAXJob) EquivalentToWorkload(wl kueue.Workload) bool {
+	replicaTypes := orderedReplicaTypes(&j.Spec)
+	podSets := wl.Spec.PodSets
+	if len(podSets) != len(replicaTypes) {
+		return false
+	}
+
+	for index, rType := range replicaTypes {
+		replicaSpec := j.Spec.JAXReplicaSpecs[rType]
+		if podSets[index].Count != podsCount(replicaSpec) {
+			return false
+		}
+
+		jobPodSpec := &replicaSpec.Template.Spec
+		if !equality.Semantic.DeepEqual(jobPodSpec.InitContainers, podSets[index].Template.Spec.InitContainers) {
+			return false
+		}
+		if !equality.Semantic.DeepEqual(jobPodSpec.Containers, podSets[index].Template.Spec.Containers) {
+			return false
+		}
+	}
+	return true
+}
+
+func (j *JAXJob) PriorityClass() string {
+	for _, rType := range orderedReplicaTypes(&j.Spec) {
+		if pc := j.Spec.JAXReplicaSpecs[rType].Template.Spec.PriorityClassName; len(pc) != 0 {
+			return pc
+		}
+	}
+	return ""
+}
+
+// PodsReady mirrors the JobSet integration's PodsReady: it sums Ready and
+// Succeeded, not Active, since Active only means a pod is currently running,
+// not that it has passed its readiness gate -- summing Active would report
+// the JAXJob ready before its pods actually are.
+func (j *JAXJob) PodsReady() bool {
+	var wantReplicas int32
+	for _, replicaSpec := range j.Spec.JAXReplicaSpecs {
+		wantReplicas += pointer.Int32Deref(replicaSpec.Replicas, 1)
+	}
+	var readyReplicas int32
+	for _, replicaStatus := range j.Status.ReplicaStatuses {
+		readyReplicas += replicaStatus.Ready + replicaStatus.Succeeded
+	}
+	return wantReplicas == readyReplicas
+}
+
+func podsCount(replicaSpec *commonv1.ReplicaSpec) int32 {
+	return pointer.Int32Deref(replicaSpec.Replicas, 1)
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+func GetWorkloadNameForJAXJob(jaxJobName string) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(jaxJobName, gvk)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jax
+
+import (
+	"testing"
+
+	jaxjobapi "github.com/kubeflow/training-operator/pkg/apis/jaxjob/v1"
+	commonv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow/common/v1"
+	"k8s.io/utils/pointer"
+)
+
+func testJAXJob(replicaCounts map[commonv1.ReplicaType]int32) *JAXJob {
+	specs := make(map[commonv1.ReplicaType]*commonv1.ReplicaSpec, len(replicaCounts))
+	for rType, replicas := range replicaCounts {
+		specs[rType] = &commonv1.ReplicaSpec{
+			Replicas: pointer.Int32(replicas),
+		}
+	}
+	return &JAXJob{
+		Spec: jaxjobapi.JAXJobSpec{
+			JAXReplicaSpecs: specs,
+		},
+	}
+}
+
+func TestOrderedReplicaTypesIsDeterministic(t *testing.T) {
+	job := testJAXJob(map[commonv1.ReplicaType]int32{"Worker": 4, "Coordinator": 1})
+	first := orderedReplicaTypes(&job.Spec)
+	for i := 0; i < 10; i++ {
+		got := orderedReplicaTypes(&job.Spec)
+		if len(got) != len(first) {
+			t.Fatalf("orderedReplicaTypes() returned %v, want same length as %v", got, first)
+		}
+		for index := range got {
+			if got[index] != first[index] {
+				t.Fatalf("orderedReplicaTypes() returned %v on repeat call, want %v", got, first)
+			}
+		}
+	}
+}
+
+func TestJAXJobPodsReady(t *testing.T) {
+	cases := map[string]struct {
+		replicaStatuses map[commonv1.ReplicaType]*commonv1.ReplicaStatus
+		want            bool
+	}{
+		"ready and succeeded together cover all wanted replicas": {
+			replicaStatuses: map[commonv1.ReplicaType]*commonv1.ReplicaStatus{
+				"Worker": {Ready: 1, Succeeded: 1},
+			},
+			want: true,
+		},
+		"active pods that have not passed their readiness gate are not counted as ready": {
+			replicaStatuses: map[commonv1.ReplicaType]*commonv1.ReplicaStatus{
+				"Worker": {Active: 2},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job := testJAXJob(map[commonv1.ReplicaType]int32{"Worker": 2})
+			job.Status.ReplicaStatuses = tc.replicaStatuses
+			if got := job.PodsReady(); got != tc.want {
+				t.Errorf("PodsReady() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
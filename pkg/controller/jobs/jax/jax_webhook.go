@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jax
+
+import (
+	"context"
+
+	jaxjobapi "github.com/kubeflow/training-operator/pkg/apis/jaxjob/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+// JAXJobWebhook defaults and validates JAXJobs the same way every other
+// jobframework integration does: a JAXJob destined for a local queue is
+// defaulted to suspended on create so it doesn't start running before Kueue
+// admits it, and the queue-name annotation and suspend state are validated
+// immutable the way jobframework expects.
+type JAXJobWebhook struct {
+	manageJobsWithoutQueueName bool
+}
+
+func SetupJAXJobWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &JAXJobWebhook{
+		manageJobsWithoutQueueName: options.ManageJobsWithoutQueueName,
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&jaxjobapi.JAXJob{}).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-kubeflow-org-v1-jaxjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=jaxjobs,verbs=create,versions=v1,name=mjaxjob.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-kubeflow-org-v1-jaxjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=jaxjobs,verbs=create;update,versions=v1,name=vjaxjob.kb.io,admissionReviewVersions=v1
+
+var _ webhook.CustomDefaulter = (*JAXJobWebhook)(nil)
+var _ webhook.CustomValidator = (*JAXJobWebhook)(nil)
+
+func (w *JAXJobWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	job := fromObject(obj)
+	jobframework.ApplyDefaultForSuspend(job, w.manageJobsWithoutQueueName)
+	return nil
+}
+
+func (w *JAXJobWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	job := fromObject(obj)
+	return nil, jobframework.ValidateJobOnCreate(job).ToAggregate()
+}
+
+func (w *JAXJobWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldJob := fromObject(oldObj)
+	newJob := fromObject(newObj)
+	return nil, jobframework.ValidateJobOnUpdate(oldJob, newJob).ToAggregate()
+}
+
+func (w *JAXJobWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jax
+
+import (
+	"context"
+	"testing"
+
+	jaxjobapi "github.com/kubeflow/training-operator/pkg/apis/jaxjob/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// queueNameAnnotation mirrors jobframework's well-known queue-name
+// annotation key so this test doesn't need to import the jobframework
+// package just to assert against it.
+const queueNameAnnotation = "kueue.x-k8s.io/queue-name"
+
+func TestJAXJobWebhookDefault(t *testing.T) {
+	cases := map[string]struct {
+		webhook     *JAXJobWebhook
+		annotations map[string]string
+		wantSuspend bool
+	}{
+		"suspends a JAXJob submitted to a local queue": {
+			webhook:     &JAXJobWebhook{},
+			annotations: map[string]string{queueNameAnnotation: "main"},
+			wantSuspend: true,
+		},
+		"leaves an unqueued JAXJob alone": {
+			webhook:     &JAXJobWebhook{},
+			annotations: nil,
+			wantSuspend: false,
+		},
+		"manageJobsWithoutQueueName suspends even without a queue name": {
+			webhook:     &JAXJobWebhook{manageJobsWithoutQueueName: true},
+			annotations: nil,
+			wantSuspend: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job := &jaxjobapi.JAXJob{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+			}
+			if err := tc.webhook.Default(context.Background(), job); err != nil {
+				t.Fatalf("Default() returned error: %v", err)
+			}
+			gotSuspend := job.Spec.RunPolicy.Suspend != nil && *job.Spec.RunPolicy.Suspend
+			if gotSuspend != tc.wantSuspend {
+				t.Errorf("Suspend = %v, want %v", gotSuspend, tc.wantSuspend)
+			}
+		})
+	}
+}
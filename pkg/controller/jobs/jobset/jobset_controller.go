@@ -18,6 +18,8 @@ package jobset
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -35,6 +37,14 @@ import (
 	"sigs.k8s.io/kueue/pkg/util/maps"
 )
 
+const (
+	// minCountAnnotation, set on a ReplicatedJob's pod template, lets a
+	// JobSet opt a given replica type into elastic/partial admission by
+	// declaring the minimum number of pods the scheduler may admit for it.
+	// Replica types without it remain gang-scheduled at their full count.
+	minCountAnnotation = "kueue.x-k8s.io/podset-min-count"
+)
+
 var (
 	gvk           = jobsetapi.GroupVersion.WithKind("JobSet")
 	FrameworkName = "jobset.x-k8s.io/jobset"
@@ -83,8 +93,12 @@ func (j *JobSet) IsSuspended() bool {
 }
 
 func (j *JobSet) IsActive() bool {
-	// ToDo implement from jobset side jobset.status.Active != 0
-	return !j.IsSuspended()
+	for _, replicatedJobStatus := range j.Status.ReplicatedJobsStatus {
+		if replicatedJobStatus.Active > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 func (j *JobSet) Suspend() {
@@ -106,12 +120,27 @@ func (j *JobSet) PodSets() []kueue.PodSet {
 			Name:     replicatedJob.Name,
 			Template: *replicatedJob.Template.Spec.Template.DeepCopy(),
 			Count:    podsCount(&replicatedJob),
+			// Carried per ReplicatedJob, rather than collapsed to a single
+			// JobSet-wide priority, so EquivalentToWorkload can re-detect
+			// drift per replica type and so pkg/scheduler/preemption can
+			// target individual low-priority replica types (e.g. a
+			// parameter-server ReplicatedJob) for preemption instead of the
+			// whole JobSet (see preemption.PreemptablePodSets).
+			PriorityClassName: replicatedJob.Template.Spec.Template.Spec.PriorityClassName,
+			// Allows this replica type to be admitted with fewer than Count
+			// pods; nil for replica types that must be gang-scheduled in full.
+			MinCount: minCount(&replicatedJob),
 		}
 	}
 	return podSets
 }
 
 func (j *JobSet) RunWithPodSetsInfo(podSetInfos []jobframework.PodSetInfo) {
+	// The generic reconciler only calls RunWithPodSetsInfo once IsActive
+	// reports false, i.e. once JobSet has deleted the child Jobs it spawns
+	// on suspend, so the node selectors set here are guaranteed to land on
+	// the Jobs JobSet creates when it unsuspends, rather than racing with
+	// Jobs still being torn down.
 	j.Spec.Suspend = pointer.Bool(false)
 	if len(podSetInfos) == 0 {
 		return
@@ -120,8 +149,16 @@ func (j *JobSet) RunWithPodSetsInfo(podSetInfos []jobframework.PodSetInfo) {
 	// for initially unsuspend, this should be enough however if the jobs are already created
 	// the node selectors should be updated on each of them
 	for index := range j.Spec.ReplicatedJobs {
-		templateSpec := &j.Spec.ReplicatedJobs[index].Template.Spec.Template.Spec
+		replicatedJob := &j.Spec.ReplicatedJobs[index]
+		templateSpec := &replicatedJob.Template.Spec.Template.Spec
 		templateSpec.NodeSelector = maps.MergeKeepFirst(podSetInfos[index].NodeSelector, templateSpec.NodeSelector)
+		// For elastic PodSets, write the admitted count back as the number of
+		// replicas so the JobSet (and everything that reads its spec
+		// afterwards, e.g. EquivalentToWorkload, PodsReady) runs against what
+		// was actually admitted rather than what was originally requested.
+		if podSetInfos[index].Count != nil {
+			replicatedJob.Replicas = admittedReplicas(replicatedJob, *podSetInfos[index].Count)
+		}
 	}
 }
 
@@ -129,6 +166,11 @@ func (j *JobSet) RestorePodSetsInfo(podSetInfos []jobframework.PodSetInfo) {
 	if len(podSetInfos) == 0 {
 		return
 	}
+	// JobSet's webhook only allows the pod templates to be mutated while
+	// oldJS.Spec.Suspend is true or the incoming update also sets Suspend to
+	// true, so flip Suspend here, in the same update that restores the node
+	// selectors, rather than relying on a prior Suspend() call to have landed.
+	j.Spec.Suspend = pointer.Bool(true)
 	for index := range j.Spec.ReplicatedJobs {
 		if equality.Semantic.DeepEqual(j.Spec.ReplicatedJobs[index].Template.Spec.Template.Spec.NodeSelector, podSetInfos[index].NodeSelector) {
 			continue
@@ -166,11 +208,14 @@ func (j *JobSet) EquivalentToWorkload(wl kueue.Workload) bool {
 	}
 
 	for index := range j.Spec.ReplicatedJobs {
-		if wl.Spec.PodSets[index].Count != podsCount(&j.Spec.ReplicatedJobs[index]) {
+		if !countWithinAdmittedRange(podSets[index], podsCount(&j.Spec.ReplicatedJobs[index])) {
 			return false
 		}
 
 		jobPodSpec := &j.Spec.ReplicatedJobs[index].Template.Spec.Template.Spec
+		if jobPodSpec.PriorityClassName != podSets[index].PriorityClassName {
+			return false
+		}
 		if !equality.Semantic.DeepEqual(jobPodSpec.InitContainers, podSets[index].Template.Spec.InitContainers) {
 			return false
 		}
@@ -181,6 +226,10 @@ func (j *JobSet) EquivalentToWorkload(wl kueue.Workload) bool {
 	return true
 }
 
+// PriorityClass returns the Workload-level priority class, used for queue
+// admission ordering. Per-ReplicatedJob priority, used by
+// pkg/scheduler/preemption to target individual replica types, is carried
+// on each PodSet instead (see PodSets).
 func (j *JobSet) PriorityClass() string {
 	for _, replicatedJob := range j.Spec.ReplicatedJobs {
 		if len(replicatedJob.Template.Spec.Template.Spec.PriorityClassName) != 0 {
@@ -190,6 +239,11 @@ func (j *JobSet) PriorityClass() string {
 	return ""
 }
 
+// PodsReady compares against j.Spec.ReplicatedJobs[*].Replicas, which
+// RunWithPodSetsInfo rewrites to the admitted count for elastic PodSets, so a
+// shrunk JobSet is considered ready once it has enough Ready+Succeeded child
+// Jobs for what was actually admitted, without waiting on the replicas it
+// gave up quota for.
 func (j *JobSet) PodsReady() bool {
 	var replicas int32
 	for _, replicatedJob := range j.Spec.ReplicatedJobs {
@@ -202,15 +256,110 @@ func (j *JobSet) PodsReady() bool {
 	return replicas == jobsReady
 }
 
+var _ jobframework.JobWithReclaimablePods = (*JobSet)(nil)
+
+// ReclaimablePods reports, per ReplicatedJob, how many of its admitted pods
+// have already succeeded and so won't be replaced. This lets Kueue release
+// quota for a replica type that finished early while the rest of the JobSet
+// keeps running, without waiting on the whole JobSet to complete. JobSet
+// itself already records each child Job that succeeds in
+// ReplicatedJobsStatus[*].Succeeded (a count of Jobs, not pods), so the pod
+// count is that number times the ReplicatedJob's per-replica pod count, and
+// Kueue doesn't need a finalizer of its own to track it.
+func (j *JobSet) ReclaimablePods() ([]kueue.ReclaimablePod, error) {
+	reclaimable := make([]kueue.ReclaimablePod, 0, len(j.Spec.ReplicatedJobs))
+	for _, replicatedJob := range j.Spec.ReplicatedJobs {
+		status := replicatedJobStatus(j, replicatedJob.Name)
+		if status == nil || status.Succeeded == 0 {
+			continue
+		}
+		reclaimable = append(reclaimable, kueue.ReclaimablePod{
+			Name:  replicatedJob.Name,
+			Count: status.Succeeded * replicaPodsCount(&replicatedJob),
+		})
+	}
+	return reclaimable, nil
+}
+
+func replicatedJobStatus(j *JobSet, name string) *jobsetapi.ReplicatedJobStatus {
+	for index := range j.Status.ReplicatedJobsStatus {
+		if j.Status.ReplicatedJobsStatus[index].Name == name {
+			return &j.Status.ReplicatedJobsStatus[index]
+		}
+	}
+	return nil
+}
+
 func podsCount(rj *jobsetapi.ReplicatedJob) int32 {
-	replicas := rj.Replicas
+	return int32(rj.Replicas) * replicaPodsCount(rj)
+}
+
+// replicaPodsCount returns the fixed number of pods a single replica of rj
+// runs, i.e. the effective Parallelism of its Job template.
+func replicaPodsCount(rj *jobsetapi.ReplicatedJob) int32 {
 	job := rj.Template
 	// parallelism is always set as it is otherwise defaulted by k8s to 1
 	jobPodsCount := pointer.Int32Deref(job.Spec.Parallelism, 1)
 	if comp := pointer.Int32Deref(job.Spec.Completions, jobPodsCount); comp < jobPodsCount {
 		jobPodsCount = comp
 	}
-	return int32(replicas) * jobPodsCount
+	return jobPodsCount
+}
+
+// countWithinAdmittedRange reports whether actualCount is a count the
+// scheduler could have legitimately admitted this PodSet at: exactly
+// podSet.Count for gang-scheduled replica types, or anywhere in
+// [podSet.MinCount, podSet.Count] for elastic ones. RunWithPodSetsInfo
+// rewrites an elastic ReplicatedJob's Replicas down to the admitted count,
+// so comparing against podSet.Count alone would treat every successfully
+// elastic-admitted JobSet as perpetually out of sync with its workload.
+func countWithinAdmittedRange(podSet kueue.PodSet, actualCount int32) bool {
+	if podSet.MinCount == nil {
+		return actualCount == podSet.Count
+	}
+	return actualCount >= *podSet.MinCount && actualCount <= podSet.Count
+}
+
+// minCount reads the minCountAnnotation off a ReplicatedJob's actual pod
+// template (rj.Template.Spec.Template, the PodTemplateSpec its Job creates
+// pods from -- not rj.Template's own metadata) and returns the minimum pod
+// count the scheduler may admit for it, or nil if the replica type isn't
+// elastic. The raw value is rounded up to the nearest multiple of the
+// ReplicatedJob's per-replica pod count so the scheduler is never handed a
+// MinCount that admittedReplicas can't convert back into a whole number of
+// replicas without over-admitting.
+func minCount(rj *jobsetapi.ReplicatedJob) *int32 {
+	raw, ok := rj.Template.Spec.Template.Annotations[minCountAnnotation]
+	if !ok {
+		return nil
+	}
+	count, err := strconv.Atoi(raw)
+	if err != nil {
+		return nil
+	}
+	if jobPodsCount := replicaPodsCount(rj); jobPodsCount != 0 {
+		if rem := int32(count) % jobPodsCount; rem != 0 {
+			count += int(jobPodsCount - rem)
+		}
+	}
+	return pointer.Int32(int32(count))
+}
+
+// admittedReplicas converts an admitted pod count back into the number of
+// ReplicatedJob replicas that produces it, given the ReplicatedJob's fixed
+// per-replica pod count (Parallelism/Completions). Floors on a non-exact
+// multiple rather than rounding up: rounding up would write back more
+// replicas, and so more pods, than the scheduler actually admitted, silently
+// over-admitting the ReplicatedJob past the quota it was granted.
+func admittedReplicas(rj *jobsetapi.ReplicatedJob, admittedCount int32) int32 {
+	jobPodsCount := replicaPodsCount(rj)
+	if jobPodsCount == 0 {
+		return 0
+	}
+	if rem := admittedCount % jobPodsCount; rem != 0 {
+		utilruntime.HandleError(fmt.Errorf("jobset: admitted count %d for replicated job %q is not a multiple of its per-replica pod count %d, flooring to avoid over-admitting", admittedCount, rj.Name, jobPodsCount))
+	}
+	return admittedCount / jobPodsCount
 }
 
 func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
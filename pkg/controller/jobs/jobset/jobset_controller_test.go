@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobset
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	jobsetapi "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+func testReplicatedJob(name string, replicas, parallelism int32, podTemplateAnnotations map[string]string) jobsetapi.ReplicatedJob {
+	return jobsetapi.ReplicatedJob{
+		Name:     name,
+		Replicas: replicas,
+		Template: batchv1.JobTemplateSpec{
+			Spec: batchv1.JobSpec{
+				Parallelism: pointer.Int32(parallelism),
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Annotations: podTemplateAnnotations},
+				},
+			},
+		},
+	}
+}
+
+func TestMinCount(t *testing.T) {
+	cases := map[string]struct {
+		rj   jobsetapi.ReplicatedJob
+		want *int32
+	}{
+		"not elastic": {
+			rj:   testReplicatedJob("worker", 4, 2, nil),
+			want: nil,
+		},
+		"exact replica boundary": {
+			rj:   testReplicatedJob("worker", 4, 2, map[string]string{minCountAnnotation: "4"}),
+			want: pointer.Int32(4),
+		},
+		"rounds up to the next replica boundary": {
+			rj:   testReplicatedJob("worker", 4, 2, map[string]string{minCountAnnotation: "3"}),
+			want: pointer.Int32(4),
+		},
+		"annotation on the Job template is ignored": {
+			rj: func() jobsetapi.ReplicatedJob {
+				rj := testReplicatedJob("worker", 4, 2, nil)
+				rj.Template.Annotations = map[string]string{minCountAnnotation: "2"}
+				return rj
+			}(),
+			want: nil,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := minCount(&tc.rj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("minCount() returned unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdmittedReplicas(t *testing.T) {
+	cases := map[string]struct {
+		rj            jobsetapi.ReplicatedJob
+		admittedCount int32
+		want          int32
+	}{
+		"exact multiple": {
+			rj:            testReplicatedJob("worker", 4, 2, nil),
+			admittedCount: 4,
+			want:          2,
+		},
+		"floors rather than over-admitting on a non-exact multiple": {
+			rj:            testReplicatedJob("worker", 4, 2, nil),
+			admittedCount: 3,
+			want:          1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := admittedReplicas(&tc.rj, tc.admittedCount); got != tc.want {
+				t.Errorf("admittedReplicas() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountWithinAdmittedRange(t *testing.T) {
+	cases := map[string]struct {
+		podSet      kueue.PodSet
+		actualCount int32
+		want        bool
+	}{
+		"gang-scheduled requires an exact match": {
+			podSet:      kueue.PodSet{Count: 4},
+			actualCount: 3,
+			want:        false,
+		},
+		"elastic within the admitted range": {
+			podSet:      kueue.PodSet{Count: 4, MinCount: pointer.Int32(2)},
+			actualCount: 2,
+			want:        true,
+		},
+		"elastic below MinCount": {
+			podSet:      kueue.PodSet{Count: 4, MinCount: pointer.Int32(2)},
+			actualCount: 1,
+			want:        false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := countWithinAdmittedRange(tc.podSet, tc.actualCount); got != tc.want {
+				t.Errorf("countWithinAdmittedRange() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJobSetReclaimablePods(t *testing.T) {
+	js := &JobSet{
+		Spec: jobsetapi.JobSetSpec{
+			ReplicatedJobs: []jobsetapi.ReplicatedJob{
+				testReplicatedJob("worker", 4, 1, nil),
+				testReplicatedJob("ps", 2, 1, nil),
+			},
+		},
+		Status: jobsetapi.JobSetStatus{
+			ReplicatedJobsStatus: []jobsetapi.ReplicatedJobStatus{
+				{Name: "worker", Succeeded: 1},
+				{Name: "ps", Succeeded: 0},
+			},
+		},
+	}
+	want := []kueue.ReclaimablePod{{Name: "worker", Count: 1}}
+	got, err := js.ReclaimablePods()
+	if err != nil {
+		t.Fatalf("ReclaimablePods() returned error: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ReclaimablePods() returned unexpected result (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"sort"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+// PriorityClassValues maps a PriorityClass name to its numeric value, the
+// same mapping the preemption path already resolves from
+// scheduling.k8s.io/v1 PriorityClass objects before ranking candidates.
+type PriorityClassValues map[string]int32
+
+// valueOf looks up a PodSet's priority, treating an empty or unknown
+// PriorityClassName as priority 0, the same default the workload-level
+// Workload priority uses for an unset PriorityClassName.
+func (v PriorityClassValues) valueOf(podSet kueue.PodSet) int32 {
+	if podSet.PriorityClassName == "" {
+		return 0
+	}
+	return v[podSet.PriorityClassName]
+}
+
+// PreemptablePodSets returns the PodSets of candidate whose priority is
+// strictly below incomingPriority, ordered from lowest to highest priority.
+// Heterogeneous workloads -- e.g. a JobSet with a low-priority
+// parameter-server ReplicatedJob and a high-priority worker one -- carry a
+// distinct PriorityClassName per PodSet (see the JobSet integration's
+// PodSets), so preempting one of these for an incoming admission targets
+// only the PodSets it is actually allowed to preempt instead of the whole
+// workload at a single collapsed priority.
+func PreemptablePodSets(candidate kueue.Workload, values PriorityClassValues, incomingPriority int32) []kueue.PodSet {
+	var below []kueue.PodSet
+	for _, podSet := range candidate.Spec.PodSets {
+		if values.valueOf(podSet) >= incomingPriority {
+			continue
+		}
+		below = append(below, podSet)
+	}
+	sort.SliceStable(below, func(i, j int) bool {
+		return values.valueOf(below[i]) < values.valueOf(below[j])
+	})
+	return below
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+func TestPreemptablePodSets(t *testing.T) {
+	values := PriorityClassValues{"low": 0, "high": 100}
+	candidate := kueue.Workload{
+		Spec: kueue.WorkloadSpec{
+			PodSets: []kueue.PodSet{
+				{Name: "worker", PriorityClassName: "high"},
+				{Name: "parameter-server", PriorityClassName: "low"},
+				{Name: "unset-priority"},
+			},
+		},
+	}
+
+	got := PreemptablePodSets(candidate, values, 50)
+	want := []kueue.PodSet{
+		{Name: "parameter-server", PriorityClassName: "low"},
+		{Name: "unset-priority"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PreemptablePodSets() returned unexpected result (-want +got):\n%s", diff)
+	}
+
+	if got := PreemptablePodSets(candidate, values, 0); len(got) != 0 {
+		t.Errorf("PreemptablePodSets() with incomingPriority 0 = %v, want none", got)
+	}
+}